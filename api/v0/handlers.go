@@ -0,0 +1,240 @@
+// Package v0 holds the v0 HTTP API: chi handlers that translate requests
+// into storage.InstanceStore calls.
+package v0
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/ImValerio/be-sharetimewithme/encoding"
+	"github.com/ImValerio/be-sharetimewithme/storage"
+	"github.com/ImValerio/be-sharetimewithme/user"
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Instance is the wire representation of a user's submitted availability.
+// OwnerID is never serialized: it is the authenticated account backing a
+// record and would otherwise leak which real account owns an instance to
+// anyone who knows its instanceId, since GET is intentionally unauthenticated.
+type Instance struct {
+	InstanceID   string   `json:"instanceId"`
+	Username     string   `json:"username"`
+	BinaryWeeks  []string `json:"binaryWeeks"`
+	CreationDate string   `json:"creationDate"`
+	OwnerID      string   `json:"-"`
+}
+
+// RegisterRoutes wires the v0 API onto r, using store for instance
+// persistence and usersCollection/jwtSecret for the user subsystem.
+func RegisterRoutes(r chi.Router, store storage.InstanceStore, usersCollection *mongo.Collection, jwtSecret string) {
+	r.Post("/register", user.RegisterHandler(usersCollection, jwtSecret))
+	r.Post("/login", user.LoginHandler(usersCollection, jwtSecret))
+	r.Get("/me", user.MeHandler(usersCollection))
+
+	r.Post("/instance", createInstanceHandler(store))
+	r.Get("/instance/{id}", getInstanceHandler(store))
+	r.Get("/instance/{id}.ics", getInstanceICSHandler(store))
+	r.Get("/instance/{id}/common.ics", getInstanceCommonICSHandler(store))
+	r.Delete("/instance/{id}/{username}", deleteInstanceHandler(store))
+}
+
+// decodeBody picks a codec from the request's Content-Type and unmarshals
+// the body into v, writing a 415 if no codec matches.
+func decodeBody(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	codec, ok := encoding.ForContentType(r.Header.Get("Content-Type"))
+	if !ok {
+		http.Error(w, "Unsupported Content-Type", http.StatusUnsupportedMediaType)
+		return false
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return false
+	}
+
+	if err := codec.Unmarshal(body, v); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return false
+	}
+	return true
+}
+
+// writeEncoded picks a codec from the request's Accept header and writes v
+// as the response body, writing a 406 if no codec matches.
+func writeEncoded(w http.ResponseWriter, r *http.Request, status int, v interface{}) {
+	codec, ok := encoding.ForAccept(r.Header.Get("Accept"))
+	if !ok {
+		http.Error(w, "Not Acceptable", http.StatusNotAcceptable)
+		return
+	}
+
+	// A codec may be registered for decoding a request body but unable to
+	// produce a given response shape (e.g. form can't marshal a slice); treat
+	// that the same as no matching codec rather than a server error.
+	body, err := codec.Marshal(v)
+	if err != nil {
+		http.Error(w, "Not Acceptable", http.StatusNotAcceptable)
+		return
+	}
+
+	w.Header().Set("Content-Type", codec.ContentType())
+	w.WriteHeader(status)
+	w.Write(body)
+}
+
+func createInstanceHandler(store storage.InstanceStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var rv Instance
+		if !decodeBody(w, r, &rv) {
+			return
+		}
+
+		if rv.Username == "" || len(rv.BinaryWeeks) == 0 {
+			http.Error(w, "Missing required fields", http.StatusBadRequest)
+			return
+		}
+
+		ownerID, ok := user.UserIDFromContext(r.Context())
+		if !ok {
+			http.Error(w, "Authentication required", http.StatusUnauthorized)
+			return
+		}
+		rv.OwnerID = ownerID
+
+		if rv.InstanceID == "" {
+			rv.InstanceID = uuid.New().String()
+		}
+
+		binaryWeeks := make([]int32, len(rv.BinaryWeeks))
+		for i, week := range rv.BinaryWeeks {
+			if !isBinaryString(week) || len(week) != 7 {
+				http.Error(w, "Invalid data :(", http.StatusBadRequest)
+				return
+			}
+			decimalWeek, err := convertBinaryToDecimal(week)
+			if err != nil {
+				http.Error(w, "There was an issue during the conversion process :(", http.StatusInternalServerError)
+				return
+			}
+			binaryWeeks[i] = decimalWeek
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+		defer cancel()
+
+		creationDate := time.Now().Format("2006/01/02")
+		if existing, err := store.GetCreationDate(ctx, rv.InstanceID); err == nil {
+			creationDate = existing
+		}
+
+		err := store.Create(ctx, storage.Instance{
+			InstanceID:   rv.InstanceID,
+			Username:     rv.Username,
+			BinaryWeeks:  binaryWeeks,
+			CreationDate: creationDate,
+			OwnerID:      rv.OwnerID,
+		})
+		if errors.Is(err, storage.ErrDuplicateUsername) {
+			http.Error(w, "Username already exists for this instance", http.StatusBadRequest)
+			return
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		writeEncoded(w, r, http.StatusOK, map[string]string{"instanceId": rv.InstanceID})
+	}
+}
+
+func getInstanceHandler(store storage.InstanceStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id := chi.URLParam(r, "id")
+
+		ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+		defer cancel()
+
+		records, err := store.GetByInstance(ctx, id)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		if r.URL.Query().Get("format") == "ics" {
+			writeICSCalendar(w, eventsForRecords(records))
+			return
+		}
+
+		instances := make([]Instance, 0, len(records))
+		for _, record := range records {
+			instances = append(instances, Instance{
+				InstanceID:   record.InstanceID,
+				Username:     record.Username,
+				BinaryWeeks:  convertDecimalWeeksToBinary(record.BinaryWeeks),
+				CreationDate: record.CreationDate,
+			})
+		}
+
+		writeEncoded(w, r, http.StatusOK, instances)
+	}
+}
+
+func deleteInstanceHandler(store storage.InstanceStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id := chi.URLParam(r, "id")
+		username := chi.URLParam(r, "username")
+
+		userID, ok := user.UserIDFromContext(r.Context())
+		if !ok {
+			http.Error(w, "Authentication required", http.StatusUnauthorized)
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+		defer cancel()
+
+		records, err := store.GetByInstance(ctx, id)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		var found, owned bool
+		for _, record := range records {
+			if record.Username == username {
+				found = true
+				// Records created before user accounts existed have no real
+				// owner to check against; leave them deletable by any
+				// authenticated user rather than permanently locked.
+				owned = record.OwnerID == userID || record.OwnerID == ""
+				break
+			}
+		}
+		if !found {
+			http.Error(w, "No records found to delete", http.StatusNotFound)
+			return
+		}
+		if !owned {
+			http.Error(w, "Forbidden", http.StatusForbidden)
+			return
+		}
+
+		err = store.Delete(ctx, id, username)
+		if errors.Is(err, storage.ErrNotFound) {
+			http.Error(w, "No records found to delete", http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		writeEncoded(w, r, http.StatusOK, map[string]string{"message": "Record deleted successfully"})
+	}
+}
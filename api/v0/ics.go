@@ -0,0 +1,156 @@
+package v0
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ImValerio/be-sharetimewithme/storage"
+	"github.com/go-chi/chi"
+)
+
+const (
+	creationDateLayout = "2006/01/02"
+	icsDateLayout      = "20060102"
+)
+
+// icsEvent is a single all-day availability slot.
+type icsEvent struct {
+	summary string
+	date    time.Time
+}
+
+// eventsFromBinaryWeeks expands a user's bitmap into one icsEvent per day
+// that is marked available, anchored on creationDate (the Monday of week 0).
+func eventsFromBinaryWeeks(summary string, creationDate time.Time, binaryWeeks []int32) []icsEvent {
+	var events []icsEvent
+	for weekIndex, week := range binaryWeeks {
+		for day := 0; day < 7; day++ {
+			bit := int32(1) << uint(6-day)
+			if week&bit == 0 {
+				continue
+			}
+			events = append(events, icsEvent{
+				summary: summary,
+				date:    creationDate.AddDate(0, 0, weekIndex*7+day),
+			})
+		}
+	}
+	return events
+}
+
+// commonBinaryWeeks ANDs every record's bitmap together, truncating to the
+// shortest bitmap, and anchors the result on the earliest creationDate.
+func commonBinaryWeeks(records []storage.Instance) ([]int32, time.Time) {
+	var earliest time.Time
+	var haveEarliest bool
+	var common []int32
+
+	for i, record := range records {
+		creationDate, err := time.Parse(creationDateLayout, record.CreationDate)
+		if err == nil && (!haveEarliest || creationDate.Before(earliest)) {
+			earliest = creationDate
+			haveEarliest = true
+		}
+
+		if i == 0 {
+			common = append([]int32(nil), record.BinaryWeeks...)
+			continue
+		}
+
+		if len(record.BinaryWeeks) < len(common) {
+			common = common[:len(record.BinaryWeeks)]
+		}
+		for weekIndex := range common {
+			common[weekIndex] &= record.BinaryWeeks[weekIndex]
+		}
+	}
+
+	return common, earliest
+}
+
+func renderCalendar(events []icsEvent) string {
+	var b strings.Builder
+	b.WriteString("BEGIN:VCALENDAR\r\n")
+	b.WriteString("VERSION:2.0\r\n")
+	b.WriteString("PRODID:-//sharetimewithme//instance export//EN\r\n")
+	b.WriteString("CALSCALE:GREGORIAN\r\n")
+
+	for i, event := range events {
+		start := event.date.Format(icsDateLayout)
+		end := event.date.AddDate(0, 0, 1).Format(icsDateLayout)
+		b.WriteString("BEGIN:VEVENT\r\n")
+		fmt.Fprintf(&b, "UID:%s-%d@sharetimewithme\r\n", start, i)
+		fmt.Fprintf(&b, "DTSTART;VALUE=DATE:%s\r\n", start)
+		fmt.Fprintf(&b, "DTEND;VALUE=DATE:%s\r\n", end)
+		fmt.Fprintf(&b, "SUMMARY:%s\r\n", escapeICSText(event.summary))
+		b.WriteString("END:VEVENT\r\n")
+	}
+
+	b.WriteString("END:VCALENDAR\r\n")
+	return b.String()
+}
+
+func escapeICSText(s string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`)
+	return replacer.Replace(s)
+}
+
+func writeICSCalendar(w http.ResponseWriter, events []icsEvent) {
+	w.Header().Set("Content-Type", "text/calendar")
+	w.Write([]byte(renderCalendar(events)))
+}
+
+func eventsForRecords(records []storage.Instance) []icsEvent {
+	var events []icsEvent
+	for _, record := range records {
+		creationDate, err := time.Parse(creationDateLayout, record.CreationDate)
+		if err != nil {
+			continue
+		}
+		events = append(events, eventsFromBinaryWeeks(record.Username, creationDate, record.BinaryWeeks)...)
+	}
+	return events
+}
+
+func getInstanceICSHandler(store storage.InstanceStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id := chi.URLParam(r, "id")
+
+		ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+		defer cancel()
+
+		records, err := store.GetByInstance(ctx, id)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		writeICSCalendar(w, eventsForRecords(records))
+	}
+}
+
+func getInstanceCommonICSHandler(store storage.InstanceStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id := chi.URLParam(r, "id")
+
+		ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+		defer cancel()
+
+		records, err := store.GetByInstance(ctx, id)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		if len(records) == 0 {
+			writeICSCalendar(w, nil)
+			return
+		}
+
+		common, creationDate := commonBinaryWeeks(records)
+		writeICSCalendar(w, eventsFromBinaryWeeks("Common availability", creationDate, common))
+	}
+}
@@ -0,0 +1,28 @@
+package v0
+
+import (
+	"fmt"
+	"strconv"
+)
+
+func isBinaryString(s string) bool {
+	for _, char := range s {
+		if char != '0' && char != '1' {
+			return false
+		}
+	}
+	return true
+}
+
+func convertBinaryToDecimal(week string) (int32, error) {
+	v, err := strconv.ParseInt(week, 2, 32)
+	return int32(v), err
+}
+
+func convertDecimalWeeksToBinary(binaryWeeks []int32) []string {
+	weeks := make([]string, len(binaryWeeks))
+	for i, week := range binaryWeeks {
+		weeks[i] = fmt.Sprintf("%07b", week)
+	}
+	return weeks
+}
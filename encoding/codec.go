@@ -0,0 +1,74 @@
+// Package encoding provides a pluggable registry of request/response body
+// codecs, so handlers pick their wire format from the Content-Type/Accept
+// headers instead of hard-coding encoding/json.
+package encoding
+
+import (
+	"mime"
+	"strings"
+)
+
+// Codec marshals and unmarshals values for a single media type.
+type Codec interface {
+	Name() string
+	ContentType() string
+	Marshal(v interface{}) ([]byte, error)
+	Unmarshal(data []byte, v interface{}) error
+}
+
+var (
+	registry     = make(map[string]Codec)
+	defaultCodec Codec
+)
+
+// Register adds codec to the global registry, keyed by its content type.
+func Register(codec Codec) {
+	registry[codec.ContentType()] = codec
+}
+
+// RegisterDefault registers codec and makes it the fallback used when a
+// request carries no usable Content-Type/Accept header.
+func RegisterDefault(codec Codec) {
+	Register(codec)
+	defaultCodec = codec
+}
+
+// ForContentType returns the codec registered for the media type carried by
+// a Content-Type header value, ignoring any parameters such as charset.
+func ForContentType(contentType string) (Codec, bool) {
+	if contentType == "" {
+		return defaultCodec, defaultCodec != nil
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return nil, false
+	}
+
+	codec, ok := registry[mediaType]
+	return codec, ok
+}
+
+// ForAccept returns the first registered codec that satisfies an Accept
+// header value, falling back to the default codec for "*/*" or a blank
+// header.
+func ForAccept(accept string) (Codec, bool) {
+	if accept == "" {
+		return defaultCodec, defaultCodec != nil
+	}
+
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		if mediaType == "*/*" {
+			return defaultCodec, defaultCodec != nil
+		}
+		if codec, ok := registry[mediaType]; ok {
+			return codec, true
+		}
+	}
+
+	return nil, false
+}
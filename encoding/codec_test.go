@@ -0,0 +1,122 @@
+package encoding
+
+import "testing"
+
+func TestForContentType(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+		wantCodec   string
+		wantOK      bool
+	}{
+		{
+			name:        "json content type",
+			contentType: "application/json",
+			wantCodec:   "json",
+			wantOK:      true,
+		},
+		{
+			name:        "json content type with charset parameter",
+			contentType: "application/json; charset=utf-8",
+			wantCodec:   "json",
+			wantOK:      true,
+		},
+		{
+			name:        "form content type",
+			contentType: "application/x-www-form-urlencoded",
+			wantCodec:   "form",
+			wantOK:      true,
+		},
+		{
+			name:        "blank content type falls back to the default codec",
+			contentType: "",
+			wantCodec:   "json",
+			wantOK:      true,
+		},
+		{
+			name:        "unregistered media type",
+			contentType: "application/xml",
+			wantOK:      false,
+		},
+		{
+			name:        "malformed media type",
+			contentType: ";;;",
+			wantOK:      false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			codec, ok := ForContentType(tt.contentType)
+			if ok != tt.wantOK {
+				t.Fatalf("ForContentType(%q) ok = %v, want %v", tt.contentType, ok, tt.wantOK)
+			}
+			if !ok {
+				return
+			}
+			if codec.Name() != tt.wantCodec {
+				t.Fatalf("ForContentType(%q) codec = %q, want %q", tt.contentType, codec.Name(), tt.wantCodec)
+			}
+		})
+	}
+}
+
+func TestForAccept(t *testing.T) {
+	tests := []struct {
+		name      string
+		accept    string
+		wantCodec string
+		wantOK    bool
+	}{
+		{
+			name:      "blank accept falls back to the default codec",
+			accept:    "",
+			wantCodec: "json",
+			wantOK:    true,
+		},
+		{
+			name:      "wildcard accept falls back to the default codec",
+			accept:    "*/*",
+			wantCodec: "json",
+			wantOK:    true,
+		},
+		{
+			name:      "single registered media type",
+			accept:    "application/x-www-form-urlencoded",
+			wantCodec: "form",
+			wantOK:    true,
+		},
+		{
+			name:      "picks the first registered media type in a list",
+			accept:    "application/xml, application/x-www-form-urlencoded, application/json",
+			wantCodec: "form",
+			wantOK:    true,
+		},
+		{
+			name:      "skips invalid entries and matches a later one",
+			accept:    ";;;, application/json",
+			wantCodec: "json",
+			wantOK:    true,
+		},
+		{
+			name:   "no registered media type matches",
+			accept: "application/xml",
+			wantOK: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			codec, ok := ForAccept(tt.accept)
+			if ok != tt.wantOK {
+				t.Fatalf("ForAccept(%q) ok = %v, want %v", tt.accept, ok, tt.wantOK)
+			}
+			if !ok {
+				return
+			}
+			if codec.Name() != tt.wantCodec {
+				t.Fatalf("ForAccept(%q) codec = %q, want %q", tt.accept, codec.Name(), tt.wantCodec)
+			}
+		})
+	}
+}
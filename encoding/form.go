@@ -0,0 +1,115 @@
+package encoding
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"strings"
+)
+
+// formCodec encodes/decodes application/x-www-form-urlencoded bodies,
+// mapping fields by their `json` struct tag so it shares naming with the
+// JSON codec. Only string and []string fields are supported, which covers
+// the handler payloads this codec exists for.
+type formCodec struct{}
+
+func (formCodec) Name() string        { return "form" }
+func (formCodec) ContentType() string { return "application/x-www-form-urlencoded" }
+
+func (formCodec) Marshal(v interface{}) ([]byte, error) {
+	values, err := structToValues(v)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(values.Encode()), nil
+}
+
+func (formCodec) Unmarshal(data []byte, v interface{}) error {
+	values, err := url.ParseQuery(string(data))
+	if err != nil {
+		return err
+	}
+	return valuesToStruct(values, v)
+}
+
+func init() {
+	Register(formCodec{})
+}
+
+func structToValues(v interface{}) (url.Values, error) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("encoding: form codec only supports structs, got %s", rv.Kind())
+	}
+
+	values := url.Values{}
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		name := formFieldName(rt.Field(i))
+		if name == "" {
+			continue
+		}
+
+		field := rv.Field(i)
+		if field.Kind() == reflect.Slice {
+			for j := 0; j < field.Len(); j++ {
+				values.Add(name, fmt.Sprintf("%v", field.Index(j).Interface()))
+			}
+			continue
+		}
+		values.Set(name, fmt.Sprintf("%v", field.Interface()))
+	}
+	return values, nil
+}
+
+func valuesToStruct(values url.Values, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("encoding: form codec requires a non-nil pointer, got %s", rv.Kind())
+	}
+	rv = rv.Elem()
+	if rv.Kind() != reflect.Struct {
+		return fmt.Errorf("encoding: form codec only supports structs, got %s", rv.Kind())
+	}
+
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		name := formFieldName(rt.Field(i))
+		if name == "" {
+			continue
+		}
+
+		raw, ok := values[name]
+		if !ok || len(raw) == 0 {
+			continue
+		}
+
+		field := rv.Field(i)
+		switch field.Kind() {
+		case reflect.Slice:
+			slice := reflect.MakeSlice(field.Type(), len(raw), len(raw))
+			for j, item := range raw {
+				slice.Index(j).SetString(item)
+			}
+			field.Set(slice)
+		case reflect.String:
+			field.SetString(raw[0])
+		}
+	}
+	return nil
+}
+
+func formFieldName(f reflect.StructField) string {
+	tag := f.Tag.Get("json")
+	if tag == "" {
+		return ""
+	}
+	name := strings.Split(tag, ",")[0]
+	if name == "-" {
+		return ""
+	}
+	return name
+}
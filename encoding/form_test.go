@@ -0,0 +1,105 @@
+package encoding
+
+import (
+	"reflect"
+	"testing"
+)
+
+type formTestPayload struct {
+	Name   string   `json:"name"`
+	Tags   []string `json:"tags"`
+	Hidden string   `json:"-"`
+}
+
+func TestFormCodec_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		in   formTestPayload
+	}{
+		{
+			name: "string and slice fields",
+			in:   formTestPayload{Name: "alice", Tags: []string{"a", "b"}},
+		},
+		{
+			name: "empty slice field",
+			in:   formTestPayload{Name: "bob"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			codec := formCodec{}
+
+			data, err := codec.Marshal(tt.in)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+
+			var got formTestPayload
+			if err := codec.Unmarshal(data, &got); err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+
+			if got.Name != tt.in.Name {
+				t.Fatalf("Name = %q, want %q", got.Name, tt.in.Name)
+			}
+			if !reflect.DeepEqual(got.Tags, tt.in.Tags) && len(got.Tags)+len(tt.in.Tags) != 0 {
+				t.Fatalf("Tags = %v, want %v", got.Tags, tt.in.Tags)
+			}
+		})
+	}
+}
+
+func TestFormCodec_MarshalRejectsNonStruct(t *testing.T) {
+	codec := formCodec{}
+
+	if _, err := codec.Marshal(map[string]string{"name": "alice"}); err == nil {
+		t.Fatal("Marshal() of a map, want error")
+	}
+	if _, err := codec.Marshal([]string{"alice"}); err == nil {
+		t.Fatal("Marshal() of a slice, want error")
+	}
+}
+
+func TestFormCodec_UnmarshalRejectsNonStructPointer(t *testing.T) {
+	codec := formCodec{}
+
+	var m map[string]string
+	if err := codec.Unmarshal([]byte("name=alice"), &m); err == nil {
+		t.Fatal("Unmarshal() into a map pointer, want error")
+	}
+
+	var notAPointer formTestPayload
+	if err := codec.Unmarshal([]byte("name=alice"), notAPointer); err == nil {
+		t.Fatal("Unmarshal() into a non-pointer, want error")
+	}
+}
+
+func TestFormCodec_UnmarshalInvalidQuery(t *testing.T) {
+	codec := formCodec{}
+
+	var got formTestPayload
+	if err := codec.Unmarshal([]byte("%zz"), &got); err == nil {
+		t.Fatal("Unmarshal() of a malformed query string, want error")
+	}
+}
+
+func TestFormCodec_HonorsJSONIgnoreTag(t *testing.T) {
+	codec := formCodec{}
+
+	data, err := codec.Marshal(formTestPayload{Name: "alice", Hidden: "secret"})
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if got := string(data); got != "name=alice" {
+		t.Fatalf("Marshal() = %q, want %q", got, "name=alice")
+	}
+
+	var got formTestPayload
+	if err := codec.Unmarshal([]byte("name=alice&hidden=secret"), &got); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if got.Hidden != "" {
+		t.Fatalf("Hidden = %q, want empty (json:\"-\" fields must not round-trip)", got.Hidden)
+	}
+}
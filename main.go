@@ -6,30 +6,15 @@ import (
 	"log"
 	"net/http"
 	"os"
-	"strconv"
-	"strings"
-	"time"
 
+	"github.com/ImValerio/be-sharetimewithme/server"
+	"github.com/ImValerio/be-sharetimewithme/storage"
+	"github.com/ImValerio/be-sharetimewithme/store/mongo/migrations"
 	"github.com/joho/godotenv"
-	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
-type Instance struct {
-	InstanceID   string   `json:"instanceId"`
-	Username     string   `json:"username"`
-	BinaryWeeks  []string `json:"binaryWeeks"`
-	CreationDate string   `json:"creationDate"`
-}
-
-type MongoRecord struct {
-	InstanceID   string
-	Username     string
-	BinaryWeeks  string
-	CreationDate string
-}
-
 func main() {
 	fmt.Println("Starting server...")
 
@@ -44,6 +29,12 @@ func main() {
 	dbURI := os.Getenv("DB_URI")
 	dbName := os.Getenv("DB_NAME")
 	dbCollectionName := os.Getenv("DB_COLLECTION")
+	usersCollectionName := os.Getenv("DB_USERS_COLLECTION")
+	jwtSecret := os.Getenv("JWT_SECRET")
+	if jwtSecret == "" {
+		log.Fatal("JWT_SECRET must be set")
+	}
+
 	// MongoDB setup
 	clientOptions := options.Client().ApplyURI(dbURI)
 	client, err := mongo.Connect(context.TODO(), clientOptions)
@@ -52,10 +43,17 @@ func main() {
 	}
 	defer client.Disconnect(context.TODO())
 
-	collection := client.Database(dbName).Collection(dbCollectionName)
+	db := client.Database(dbName)
+	collection := db.Collection(dbCollectionName)
+	usersCollection := db.Collection(usersCollectionName)
 
-	r := getRouterAndSetupMiddlewares()
-	setRoutes(r, collection)
+	migrator := migrations.New(db, migrations.All(dbCollectionName, usersCollectionName)...)
+	if err := migrator.Run(context.TODO()); err != nil {
+		log.Fatal("migrations failed: ", err)
+	}
+
+	store := storage.NewMongoStore(collection)
+	r := server.New(store, usersCollection, jwtSecret)
 
 	port := os.Getenv("PORT")
 	if port == "" {
@@ -69,39 +67,3 @@ func main() {
 		log.Fatal(err)
 	}
 }
-
-func IsBinaryString(s string) bool {
-	for _, char := range s {
-		if char != '0' && char != '1' {
-			return false
-		}
-	}
-	return true
-}
-
-func convertBinaryToDecimal(week string) (int64, error) {
-	return strconv.ParseInt(week, 2, 8)
-}
-
-func convertDecimalWeekToBinary(binaryWeeks string) []string {
-	weeks := strings.Split(binaryWeeks, "|")
-	for i, week := range weeks {
-		if num, err := strconv.ParseInt(week, 10, 8); err == nil {
-			weeks[i] = fmt.Sprintf("%07b", num)
-		}
-	}
-	return weeks
-}
-
-func getCreationDateByInstanceId(collection *mongo.Collection, id string) string {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
-
-	var result MongoRecord
-	err := collection.FindOne(ctx, bson.M{"instanceId": id}).Decode(&result)
-	if err != nil {
-		return ""
-	}
-
-	return result.CreationDate
-}
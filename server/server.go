@@ -0,0 +1,36 @@
+// Package server wires the storage backend and v0 API together into a
+// runnable chi.Mux.
+package server
+
+import (
+	v0 "github.com/ImValerio/be-sharetimewithme/api/v0"
+	"github.com/ImValerio/be-sharetimewithme/storage"
+	"github.com/ImValerio/be-sharetimewithme/user"
+	"github.com/go-chi/chi"
+	"github.com/go-chi/chi/middleware"
+	"github.com/go-chi/cors"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// New builds the HTTP router for the whole service: middlewares, auth, and
+// the v0 API routes backed by store.
+func New(store storage.InstanceStore, usersCollection *mongo.Collection, jwtSecret string) *chi.Mux {
+	r := chi.NewRouter()
+	r.Use(cors.Handler(cors.Options{
+		AllowedOrigins:   []string{"https://*", "http://*"},
+		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
+		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token"},
+		ExposedHeaders:   []string{"Link"},
+		AllowCredentials: false,
+		MaxAge:           300,
+	}))
+	r.Use(middleware.RequestID)
+	r.Use(middleware.RealIP)
+	r.Use(middleware.Logger)
+	r.Use(middleware.Recoverer)
+	r.Use(user.Middleware(jwtSecret))
+
+	v0.RegisterRoutes(r, store, usersCollection, jwtSecret)
+
+	return r
+}
@@ -0,0 +1,83 @@
+package storage
+
+import (
+	"context"
+	"sync"
+)
+
+// MemoryStore is an in-memory InstanceStore. It is primarily useful for
+// tests, but is a valid standalone backend for local development.
+type MemoryStore struct {
+	mu      sync.Mutex
+	records map[string]map[string]Instance // instanceID -> username -> Instance
+}
+
+// NewMemoryStore returns an empty, ready-to-use MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{records: make(map[string]map[string]Instance)}
+}
+
+func (s *MemoryStore) Create(ctx context.Context, instance Instance) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	byUsername, ok := s.records[instance.InstanceID]
+	if !ok {
+		byUsername = make(map[string]Instance)
+		s.records[instance.InstanceID] = byUsername
+	}
+
+	if _, exists := byUsername[instance.Username]; exists {
+		return ErrDuplicateUsername
+	}
+
+	byUsername[instance.Username] = instance
+	return nil
+}
+
+func (s *MemoryStore) GetByInstance(ctx context.Context, instanceID string) ([]Instance, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	byUsername, ok := s.records[instanceID]
+	if !ok {
+		return nil, nil
+	}
+
+	instances := make([]Instance, 0, len(byUsername))
+	for _, instance := range byUsername {
+		instances = append(instances, instance)
+	}
+	return instances, nil
+}
+
+func (s *MemoryStore) Delete(ctx context.Context, instanceID string, username string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	byUsername, ok := s.records[instanceID]
+	if !ok {
+		return ErrNotFound
+	}
+	if _, exists := byUsername[username]; !exists {
+		return ErrNotFound
+	}
+
+	delete(byUsername, username)
+	return nil
+}
+
+func (s *MemoryStore) GetCreationDate(ctx context.Context, instanceID string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	byUsername, ok := s.records[instanceID]
+	if !ok || len(byUsername) == 0 {
+		return "", ErrNotFound
+	}
+
+	for _, instance := range byUsername {
+		return instance.CreationDate, nil
+	}
+	return "", ErrNotFound
+}
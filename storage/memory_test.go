@@ -0,0 +1,183 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestMemoryStore_Create(t *testing.T) {
+	tests := []struct {
+		name    string
+		seed    []Instance
+		insert  Instance
+		wantErr error
+	}{
+		{
+			name:   "first record for an instance",
+			seed:   nil,
+			insert: Instance{InstanceID: "i1", Username: "alice", BinaryWeeks: []int32{1, 2}},
+		},
+		{
+			name:    "duplicate username for same instance",
+			seed:    []Instance{{InstanceID: "i1", Username: "alice"}},
+			insert:  Instance{InstanceID: "i1", Username: "alice"},
+			wantErr: ErrDuplicateUsername,
+		},
+		{
+			name:   "same username, different instance",
+			seed:   []Instance{{InstanceID: "i1", Username: "alice"}},
+			insert: Instance{InstanceID: "i2", Username: "alice"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := NewMemoryStore()
+			for _, s := range tt.seed {
+				if err := store.Create(context.Background(), s); err != nil {
+					t.Fatalf("seeding store: %v", err)
+				}
+			}
+
+			err := store.Create(context.Background(), tt.insert)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Create() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMemoryStore_GetByInstance(t *testing.T) {
+	tests := []struct {
+		name       string
+		seed       []Instance
+		instanceID string
+		wantCount  int
+	}{
+		{
+			name:       "unknown instance returns no records",
+			seed:       nil,
+			instanceID: "missing",
+			wantCount:  0,
+		},
+		{
+			name: "returns only records for the requested instance",
+			seed: []Instance{
+				{InstanceID: "i1", Username: "alice"},
+				{InstanceID: "i1", Username: "bob"},
+				{InstanceID: "i2", Username: "carol"},
+			},
+			instanceID: "i1",
+			wantCount:  2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := NewMemoryStore()
+			for _, s := range tt.seed {
+				if err := store.Create(context.Background(), s); err != nil {
+					t.Fatalf("seeding store: %v", err)
+				}
+			}
+
+			got, err := store.GetByInstance(context.Background(), tt.instanceID)
+			if err != nil {
+				t.Fatalf("GetByInstance() error = %v", err)
+			}
+			if len(got) != tt.wantCount {
+				t.Fatalf("GetByInstance() returned %d records, want %d", len(got), tt.wantCount)
+			}
+		})
+	}
+}
+
+func TestMemoryStore_Delete(t *testing.T) {
+	tests := []struct {
+		name       string
+		seed       []Instance
+		instanceID string
+		username   string
+		wantErr    error
+	}{
+		{
+			name:       "deletes an existing record",
+			seed:       []Instance{{InstanceID: "i1", Username: "alice"}},
+			instanceID: "i1",
+			username:   "alice",
+		},
+		{
+			name:       "unknown instance",
+			seed:       nil,
+			instanceID: "missing",
+			username:   "alice",
+			wantErr:    ErrNotFound,
+		},
+		{
+			name:       "unknown username",
+			seed:       []Instance{{InstanceID: "i1", Username: "alice"}},
+			instanceID: "i1",
+			username:   "bob",
+			wantErr:    ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := NewMemoryStore()
+			for _, s := range tt.seed {
+				if err := store.Create(context.Background(), s); err != nil {
+					t.Fatalf("seeding store: %v", err)
+				}
+			}
+
+			err := store.Delete(context.Background(), tt.instanceID, tt.username)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Delete() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMemoryStore_GetCreationDate(t *testing.T) {
+	tests := []struct {
+		name       string
+		seed       []Instance
+		instanceID string
+		want       string
+		wantErr    error
+	}{
+		{
+			name:       "unknown instance",
+			seed:       nil,
+			instanceID: "missing",
+			wantErr:    ErrNotFound,
+		},
+		{
+			name:       "returns the stored creation date",
+			seed:       []Instance{{InstanceID: "i1", Username: "alice", CreationDate: "2024/01/01"}},
+			instanceID: "i1",
+			want:       "2024/01/01",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := NewMemoryStore()
+			for _, s := range tt.seed {
+				if err := store.Create(context.Background(), s); err != nil {
+					t.Fatalf("seeding store: %v", err)
+				}
+			}
+
+			got, err := store.GetCreationDate(context.Background(), tt.instanceID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("GetCreationDate() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Fatalf("GetCreationDate() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package storage
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// mongoRecord mirrors the on-disk document shape.
+type mongoRecord struct {
+	InstanceID   string  `bson:"instanceId"`
+	Username     string  `bson:"username"`
+	BinaryWeeks  []int32 `bson:"binaryWeeks"`
+	CreationDate string  `bson:"creationDate"`
+	OwnerID      string  `bson:"ownerId"`
+}
+
+// MongoStore is a MongoDB-backed InstanceStore.
+type MongoStore struct {
+	collection *mongo.Collection
+}
+
+// NewMongoStore wraps an existing collection as an InstanceStore.
+func NewMongoStore(collection *mongo.Collection) *MongoStore {
+	return &MongoStore{collection: collection}
+}
+
+func (s *MongoStore) Create(ctx context.Context, instance Instance) error {
+	_, err := s.collection.InsertOne(ctx, bson.M{
+		"instanceId":   instance.InstanceID,
+		"username":     instance.Username,
+		"binaryWeeks":  instance.BinaryWeeks,
+		"creationDate": instance.CreationDate,
+		"ownerId":      instance.OwnerID,
+	})
+	if mongo.IsDuplicateKeyError(err) {
+		return ErrDuplicateUsername
+	}
+	return err
+}
+
+func (s *MongoStore) GetByInstance(ctx context.Context, instanceID string) ([]Instance, error) {
+	cursor, err := s.collection.Find(ctx, bson.M{"instanceId": instanceID})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var records []mongoRecord
+	if err := cursor.All(ctx, &records); err != nil {
+		return nil, err
+	}
+
+	instances := make([]Instance, 0, len(records))
+	for _, r := range records {
+		instances = append(instances, Instance{
+			InstanceID:   r.InstanceID,
+			Username:     r.Username,
+			BinaryWeeks:  r.BinaryWeeks,
+			CreationDate: r.CreationDate,
+			OwnerID:      r.OwnerID,
+		})
+	}
+	return instances, nil
+}
+
+func (s *MongoStore) Delete(ctx context.Context, instanceID string, username string) error {
+	result, err := s.collection.DeleteOne(ctx, bson.M{"instanceId": instanceID, "username": username})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (s *MongoStore) GetCreationDate(ctx context.Context, instanceID string) (string, error) {
+	var result mongoRecord
+	err := s.collection.FindOne(ctx, bson.M{"instanceId": instanceID}).Decode(&result)
+	if err == mongo.ErrNoDocuments {
+		return "", ErrNotFound
+	}
+	if err != nil {
+		return "", err
+	}
+	return result.CreationDate, nil
+}
@@ -0,0 +1,31 @@
+package storage
+
+import (
+	"context"
+	"errors"
+)
+
+// Instance is a single user's submitted availability for a sharing instance.
+type Instance struct {
+	InstanceID   string
+	Username     string
+	BinaryWeeks  []int32
+	CreationDate string
+	OwnerID      string
+}
+
+// ErrDuplicateUsername is returned by Create when the username already has a
+// record for the given instance.
+var ErrDuplicateUsername = errors.New("username already exists for this instance")
+
+// ErrNotFound is returned when a lookup does not match any stored record.
+var ErrNotFound = errors.New("record not found")
+
+// InstanceStore persists Instance records. Implementations must be safe for
+// concurrent use so a single store can be shared across request goroutines.
+type InstanceStore interface {
+	Create(ctx context.Context, instance Instance) error
+	GetByInstance(ctx context.Context, instanceID string) ([]Instance, error)
+	Delete(ctx context.Context, instanceID string, username string) error
+	GetCreationDate(ctx context.Context, instanceID string) (string, error)
+}
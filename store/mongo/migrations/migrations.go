@@ -0,0 +1,118 @@
+package migrations
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// All returns the ordered set of migrations for the instance data stored in
+// dataCollection and the user accounts stored in usersCollection.
+func All(dataCollection string, usersCollection string) []Migration {
+	return []Migration{
+		{Version: Version{1, 0, 0}, Up: addInstanceIndexes(dataCollection)},
+		{Version: Version{2, 0, 0}, Up: rewriteBinaryWeeksAsIntArray(dataCollection)},
+		{Version: Version{2, 1, 0}, Up: backfillLegacyOwnerID(dataCollection)},
+		{Version: Version{2, 2, 0}, Up: addUserIndexes(usersCollection)},
+	}
+}
+
+// addInstanceIndexes replaces the application-level CountDocuments dedup
+// check with a unique compound index on {instanceId, username}, and adds a
+// plain index on instanceId to speed up the Find/FindOne lookups it backs.
+func addInstanceIndexes(dataCollection string) func(ctx context.Context, db *mongo.Database) error {
+	return func(ctx context.Context, db *mongo.Database) error {
+		_, err := db.Collection(dataCollection).Indexes().CreateMany(ctx, []mongo.IndexModel{
+			{
+				Keys:    bson.D{{Key: "instanceId", Value: 1}, {Key: "username", Value: 1}},
+				Options: options.Index().SetUnique(true),
+			},
+			{
+				Keys: bson.D{{Key: "instanceId", Value: 1}},
+			},
+		})
+		return err
+	}
+}
+
+// rewriteBinaryWeeksAsIntArray rewrites the legacy pipe-joined binaryWeeks
+// string into a native []int32, so handlers no longer need to convert
+// between the two representations on every read.
+func rewriteBinaryWeeksAsIntArray(dataCollection string) func(ctx context.Context, db *mongo.Database) error {
+	return func(ctx context.Context, db *mongo.Database) error {
+		collection := db.Collection(dataCollection)
+
+		type legacyRecord struct {
+			ID          interface{} `bson:"_id"`
+			BinaryWeeks string      `bson:"binaryWeeks"`
+		}
+
+		cursor, err := collection.Find(ctx, bson.M{"binaryWeeks": bson.M{"$type": "string"}})
+		if err != nil {
+			return err
+		}
+		defer cursor.Close(ctx)
+
+		var records []legacyRecord
+		if err := cursor.All(ctx, &records); err != nil {
+			return err
+		}
+
+		for _, record := range records {
+			var weeks []int32
+			for _, week := range strings.Split(record.BinaryWeeks, "|") {
+				if week == "" {
+					continue
+				}
+				n, err := strconv.ParseInt(week, 10, 32)
+				if err != nil {
+					return err
+				}
+				weeks = append(weeks, int32(n))
+			}
+
+			_, err := collection.UpdateOne(ctx,
+				bson.M{"_id": record.ID},
+				bson.M{"$set": bson.M{"binaryWeeks": weeks}},
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+// addUserIndexes backs RegisterHandler's application-level CountDocuments
+// dedup check with a unique index on username, so concurrent registrations
+// cannot both pass the check. RegisterHandler keeps the count as a fast path.
+func addUserIndexes(usersCollection string) func(ctx context.Context, db *mongo.Database) error {
+	return func(ctx context.Context, db *mongo.Database) error {
+		_, err := db.Collection(usersCollection).Indexes().CreateOne(ctx, mongo.IndexModel{
+			Keys:    bson.D{{Key: "username", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		})
+		return err
+	}
+}
+
+// backfillLegacyOwnerID sets ownerId on records created before user accounts
+// existed, so every document has the field rather than decoding it as an
+// implicit "". There is no real account to attribute these rows to, so they
+// are explicitly marked with the empty-string sentinel; handlers.go treats an
+// empty OwnerID as unclaimed and deletable by any authenticated user, instead
+// of silently leaving pre-auth rows permanently un-deletable.
+func backfillLegacyOwnerID(dataCollection string) func(ctx context.Context, db *mongo.Database) error {
+	return func(ctx context.Context, db *mongo.Database) error {
+		_, err := db.Collection(dataCollection).UpdateMany(ctx,
+			bson.M{"ownerId": bson.M{"$exists": false}},
+			bson.M{"$set": bson.M{"ownerId": ""}},
+		)
+		return err
+	}
+}
@@ -0,0 +1,76 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const migrationsCollectionName = "migrations"
+
+type appliedMigration struct {
+	Version   string    `bson:"version"`
+	AppliedAt time.Time `bson:"appliedAt"`
+}
+
+// Migrator applies ordered Migrations to a database, recording each applied
+// version in a migrations collection so a migration never runs twice.
+type Migrator struct {
+	db         *mongo.Database
+	migrations []Migration
+}
+
+// New returns a Migrator that applies migrations, in ascending version
+// order, against db.
+func New(db *mongo.Database, migrations ...Migration) *Migrator {
+	sorted := make([]Migration, len(migrations))
+	copy(sorted, migrations)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Version.Less(sorted[j].Version) })
+	return &Migrator{db: db, migrations: sorted}
+}
+
+// Run applies every migration that has not yet been recorded as applied.
+// Boot should refuse to serve if Run returns an error.
+func (m *Migrator) Run(ctx context.Context) error {
+	collection := m.db.Collection(migrationsCollectionName)
+
+	cursor, err := collection.Find(ctx, bson.M{})
+	if err != nil {
+		return fmt.Errorf("listing applied migrations: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var records []appliedMigration
+	if err := cursor.All(ctx, &records); err != nil {
+		return fmt.Errorf("decoding applied migrations: %w", err)
+	}
+
+	applied := make(map[string]bool, len(records))
+	for _, r := range records {
+		applied[r.Version] = true
+	}
+
+	for _, migration := range m.migrations {
+		if applied[migration.Version.String()] {
+			continue
+		}
+
+		if err := migration.Up(ctx, m.db); err != nil {
+			return fmt.Errorf("migration %s: %w", migration.Version, err)
+		}
+
+		_, err := collection.InsertOne(ctx, appliedMigration{
+			Version:   migration.Version.String(),
+			AppliedAt: time.Now(),
+		})
+		if err != nil {
+			return fmt.Errorf("recording migration %s: %w", migration.Version, err)
+		}
+	}
+
+	return nil
+}
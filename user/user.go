@@ -0,0 +1,219 @@
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// User is the MongoDB representation of a registered account.
+type User struct {
+	Username     string    `bson:"username" json:"username"`
+	PasswordHash string    `bson:"passwordHash" json:"-"`
+	CreatedAt    time.Time `bson:"createdAt" json:"createdAt"`
+}
+
+type registerRequest struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+type loginRequest struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+type contextKey string
+
+const userIDContextKey contextKey = "userId"
+
+// ContextWithUserID returns a copy of ctx carrying the authenticated user's id.
+func ContextWithUserID(ctx context.Context, userID string) context.Context {
+	return context.WithValue(ctx, userIDContextKey, userID)
+}
+
+// UserIDFromContext returns the authenticated user's id, if any was set by Middleware.
+func UserIDFromContext(ctx context.Context) (string, bool) {
+	userID, ok := ctx.Value(userIDContextKey).(string)
+	return userID, ok && userID != ""
+}
+
+func issueToken(secret string, username string) (string, error) {
+	claims := jwt.MapClaims{
+		"sub": username,
+		"exp": time.Now().Add(7 * 24 * time.Hour).Unix(),
+		"iat": time.Now().Unix(),
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString([]byte(secret))
+}
+
+// ParseToken validates tokenString and returns the username stored in its subject claim.
+func ParseToken(secret string, tokenString string) (string, error) {
+	token, err := jwt.Parse(tokenString, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, jwt.ErrSignatureInvalid
+		}
+		return []byte(secret), nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok || !token.Valid {
+		return "", jwt.ErrTokenInvalidClaims
+	}
+
+	sub, _ := claims["sub"].(string)
+	if sub == "" {
+		return "", jwt.ErrTokenInvalidClaims
+	}
+
+	return sub, nil
+}
+
+// Middleware populates the request context with the userId carried by a valid
+// "Authorization: Bearer <token>" header. Requests without a valid token are
+// passed through unauthenticated; handlers that require a logged-in user are
+// responsible for checking UserIDFromContext themselves.
+func Middleware(jwtSecret string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			authHeader := r.Header.Get("Authorization")
+			const prefix = "Bearer "
+			if len(authHeader) > len(prefix) && authHeader[:len(prefix)] == prefix {
+				tokenString := authHeader[len(prefix):]
+				if username, err := ParseToken(jwtSecret, tokenString); err == nil {
+					r = r.WithContext(ContextWithUserID(r.Context(), username))
+				}
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// RegisterHandler creates a new account with a bcrypt-hashed password.
+func RegisterHandler(collection *mongo.Collection, jwtSecret string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req registerRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if req.Username == "" || req.Password == "" {
+			http.Error(w, "Missing required fields", http.StatusBadRequest)
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		// Fast-path check only; the unique index on username plus the
+		// IsDuplicateKeyError handling below InsertOne is what actually
+		// prevents two concurrent registrations racing past this count.
+		count, err := collection.CountDocuments(ctx, bson.M{"username": req.Username})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if count > 0 {
+			http.Error(w, "Username already exists", http.StatusBadRequest)
+			return
+		}
+
+		hash, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		_, err = collection.InsertOne(ctx, User{
+			Username:     req.Username,
+			PasswordHash: string(hash),
+			CreatedAt:    time.Now(),
+		})
+		if mongo.IsDuplicateKeyError(err) {
+			http.Error(w, "Username already exists", http.StatusBadRequest)
+			return
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		token, err := issueToken(jwtSecret, req.Username)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]string{"token": token})
+	}
+}
+
+// LoginHandler verifies credentials against the stored bcrypt hash and issues a JWT.
+func LoginHandler(collection *mongo.Collection, jwtSecret string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req loginRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		var stored User
+		err := collection.FindOne(ctx, bson.M{"username": req.Username}).Decode(&stored)
+		if err != nil {
+			http.Error(w, "Invalid username or password", http.StatusUnauthorized)
+			return
+		}
+
+		if err := bcrypt.CompareHashAndPassword([]byte(stored.PasswordHash), []byte(req.Password)); err != nil {
+			http.Error(w, "Invalid username or password", http.StatusUnauthorized)
+			return
+		}
+
+		token, err := issueToken(jwtSecret, stored.Username)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]string{"token": token})
+	}
+}
+
+// MeHandler returns the profile of the currently authenticated user.
+func MeHandler(collection *mongo.Collection) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		username, ok := UserIDFromContext(r.Context())
+		if !ok {
+			http.Error(w, "Authentication required", http.StatusUnauthorized)
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		var stored User
+		if err := collection.FindOne(ctx, bson.M{"username": username}).Decode(&stored); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(stored)
+	}
+}